@@ -0,0 +1,178 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func newTestIssuer(t *testing.T) *Issuer {
+	t.Helper()
+	provider, err := NewStaticKeyProvider("test-key", []byte("deterministic-test-secret"))
+	if err != nil {
+		t.Fatalf("NewStaticKeyProvider: %v", err)
+	}
+	return NewIssuer(provider, jwt.SigningMethodHS256, time.Hour)
+}
+
+func newTestRouter(issuer *Issuer) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(issuer.Middleware())
+	router.GET("/me", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"subject": ClaimsFromContext(c).Subject})
+	})
+	router.GET("/admin", RequireRole("admin"), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	router.GET("/billing", RequireScope("billing:write"), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+func TestMiddlewareRejectsMissingAndInvalidTokens(t *testing.T) {
+	router := newTestRouter(newTestIssuer(t))
+
+	for _, tc := range []struct {
+		name   string
+		header string
+	}{
+		{"missing header", ""},
+		{"garbage token", "Bearer not-a-jwt"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/me", nil)
+			if tc.header != "" {
+				req.Header.Set("Authorization", tc.header)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+			}
+		})
+	}
+}
+
+func TestMiddlewareAcceptsIssuedToken(t *testing.T) {
+	issuer := newTestIssuer(t)
+	router := newTestRouter(issuer)
+
+	token, err := issuer.Issue("user-1", nil, "")
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/me", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d, body = %s", rec.Code, http.StatusOK, rec.Body)
+	}
+}
+
+func TestRequireRoleAndScope(t *testing.T) {
+	issuer := newTestIssuer(t)
+	router := newTestRouter(issuer)
+
+	adminToken, err := issuer.Issue("user-1", []string{"admin"}, "")
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	userToken, err := issuer.Issue("user-2", []string{"member"}, "billing:write reports:read")
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name       string
+		path       string
+		token      string
+		wantStatus int
+	}{
+		{"admin allowed", "/admin", adminToken, http.StatusOK},
+		{"member denied admin", "/admin", userToken, http.StatusForbidden},
+		{"scoped allowed", "/billing", userToken, http.StatusOK},
+		{"unscoped denied", "/billing", adminToken, http.StatusForbidden},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			req.Header.Set("Authorization", "Bearer "+tc.token)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			if rec.Code != tc.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tc.wantStatus)
+			}
+		})
+	}
+}
+
+func TestRotatingKeyProviderKeepsVerifyingRetiredKeys(t *testing.T) {
+	provider, err := NewRotatingKeyProvider("v1", []byte("key-v1"))
+	if err != nil {
+		t.Fatalf("NewRotatingKeyProvider: %v", err)
+	}
+	issuer := NewIssuer(provider, jwt.SigningMethodHS256, time.Hour)
+	router := newTestRouter(issuer)
+
+	oldToken, err := issuer.Issue("user-1", nil, "")
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+
+	if err := provider.Rotate("v2", []byte("key-v2")); err != nil {
+		t.Fatalf("Rotate: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/me", nil)
+	req.Header.Set("Authorization", "Bearer "+oldToken)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("token signed under retired key: status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	provider.Forget("v1")
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("token signed under forgotten key: status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestRS256RoundTrip(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	provider, err := NewStaticKeyProvider("rsa-test-key", privateKey)
+	if err != nil {
+		t.Fatalf("NewStaticKeyProvider: %v", err)
+	}
+	issuer := NewIssuer(provider, jwt.SigningMethodRS256, time.Hour)
+	router := newTestRouter(issuer)
+
+	token, err := issuer.Issue("user-1", []string{"admin"}, "")
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d, body = %s", rec.Code, http.StatusOK, rec.Body)
+	}
+}
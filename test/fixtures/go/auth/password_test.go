@@ -0,0 +1,44 @@
+package auth
+
+import "testing"
+
+func TestHashAndVerifyPassword(t *testing.T) {
+	params := DefaultArgon2Params()
+
+	encoded, err := HashPassword("correct horse battery staple", params)
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+
+	ok, err := VerifyPassword("correct horse battery staple", encoded)
+	if err != nil {
+		t.Fatalf("VerifyPassword: %v", err)
+	}
+	if !ok {
+		t.Error("VerifyPassword: correct password reported as invalid")
+	}
+
+	ok, err = VerifyPassword("wrong password", encoded)
+	if err != nil {
+		t.Fatalf("VerifyPassword: %v", err)
+	}
+	if ok {
+		t.Error("VerifyPassword: wrong password reported as valid")
+	}
+}
+
+func TestHashPasswordUniqueSalts(t *testing.T) {
+	params := DefaultArgon2Params()
+
+	a, err := HashPassword("same password", params)
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+	b, err := HashPassword("same password", params)
+	if err != nil {
+		t.Fatalf("HashPassword: %v", err)
+	}
+	if a == b {
+		t.Error("HashPassword produced identical encodings for two calls; salts should differ")
+	}
+}
@@ -0,0 +1,65 @@
+// Package cbor adds CBOR (RFC 8949) request binding and response
+// rendering to Gin, alongside its built-in JSON support. It gives
+// handlers binding.Binding/render.Render implementations so they can
+// accept "application/cbor" request bodies and answer with CBOR when the
+// client's Accept header asks for it, without losing validator tag
+// enforcement on decoded structs.
+package cbor
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// MIMECBOR is the content type negotiated for CBOR bodies and responses.
+const MIMECBOR = "application/cbor"
+
+// cborBinding implements binding.Binding and binding.BindingBody the same
+// way Gin's own jsonBinding does, running struct validation after a
+// successful decode.
+type cborBinding struct{}
+
+// Binding is the binding.Binding implementation for application/cbor
+// request bodies, for use with c.ShouldBindWith / c.MustBindWith:
+//
+//	var req CreateWidgetRequest
+//	if err := c.ShouldBindWith(&req, cbor.Binding); err != nil { ... }
+var Binding binding.Binding = cborBinding{}
+
+// BindingBody is Binding re-exposed as binding.BindingBody, for use with
+// c.ShouldBindBodyWith.
+var BindingBody binding.BindingBody = cborBinding{}
+
+func (cborBinding) Name() string {
+	return "cbor"
+}
+
+func (cborBinding) Bind(req *http.Request, obj any) error {
+	if req == nil || req.Body == nil {
+		return errors.New("cbor: invalid request")
+	}
+	return decodeCBOR(req.Body, obj)
+}
+
+func (cborBinding) BindBody(body []byte, obj any) error {
+	return decodeCBOR(bytes.NewReader(body), obj)
+}
+
+func decodeCBOR(r io.Reader, obj any) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if err := cbor.Unmarshal(data, obj); err != nil {
+		return err
+	}
+	if binding.Validator == nil {
+		return nil
+	}
+	return binding.Validator.ValidateStruct(obj)
+}
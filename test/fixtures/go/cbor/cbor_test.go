@@ -0,0 +1,77 @@
+package cbor
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	fxcbor "github.com/fxamacker/cbor/v2"
+	"github.com/gin-gonic/gin"
+)
+
+type widgetRequest struct {
+	Name  string `cbor:"name" binding:"required"`
+	Count int    `cbor:"count" binding:"required,gt=0"`
+}
+
+func newRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/widgets", func(c *gin.Context) {
+		var req widgetRequest
+		if err := c.ShouldBindWith(&req, Binding); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		CBOR(c, http.StatusCreated, req)
+	})
+	return router
+}
+
+func TestBindAndRenderCBOR(t *testing.T) {
+	router := newRouter()
+
+	body, err := fxcbor.Marshal(widgetRequest{Name: "sprocket", Count: 3})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/widgets", bytes.NewReader(body))
+	req.Header.Set("Content-Type", MIMECBOR)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d, body = %x", rec.Code, http.StatusCreated, rec.Body.Bytes())
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != MIMECBOR {
+		t.Errorf("Content-Type = %q, want %q", ct, MIMECBOR)
+	}
+
+	var got widgetRequest
+	if err := fxcbor.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("Unmarshal response: %v", err)
+	}
+	if got.Name != "sprocket" || got.Count != 3 {
+		t.Errorf("got %+v, want {sprocket 3}", got)
+	}
+}
+
+func TestBindCBORRunsValidation(t *testing.T) {
+	router := newRouter()
+
+	body, err := fxcbor.Marshal(widgetRequest{Name: "sprocket", Count: 0})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/widgets", bytes.NewReader(body))
+	req.Header.Set("Content-Type", MIMECBOR)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d (validation should reject count=0)", rec.Code, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,56 @@
+package cbor
+
+import (
+	"net/http"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/gin-gonic/gin"
+)
+
+var cborContentType = []string{MIMECBOR}
+
+// Render is the render.Render implementation for a CBOR response body,
+// for use with c.Render:
+//
+//	c.Render(http.StatusOK, cbor.Render{Data: widget})
+type Render struct {
+	Data any
+}
+
+// Render encodes r.Data as CBOR and writes it to w.
+func (r Render) Render(w http.ResponseWriter) error {
+	r.WriteContentType(w)
+	data, err := cbor.Marshal(r.Data)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteContentType sets w's Content-Type to application/cbor unless a
+// Content-Type is already set.
+func (r Render) WriteContentType(w http.ResponseWriter) {
+	header := w.Header()
+	if _, exists := header["Content-Type"]; !exists {
+		header["Content-Type"] = cborContentType
+	}
+}
+
+// CBOR writes obj to c as a CBOR response with the given status code,
+// mirroring the signature of Gin's own c.JSON.
+func CBOR(c *gin.Context, code int, obj any) {
+	c.Render(code, Render{Data: obj})
+}
+
+// NegotiateCBOR is a convenience for handlers that must honor the
+// request's Accept header: it renders obj as CBOR if the client accepts
+// application/cbor, falling back to JSON otherwise.
+func NegotiateCBOR(c *gin.Context, code int, obj any) {
+	switch c.NegotiateFormat(MIMECBOR, gin.MIMEJSON) {
+	case MIMECBOR:
+		CBOR(c, code, obj)
+	default:
+		c.JSON(code, obj)
+	}
+}
@@ -0,0 +1,179 @@
+// Package i18n negotiates a request locale from the Accept-Language header
+// and translates validator.ValidationErrors into a structured JSON error
+// body, using go-playground/universal-translator under the hood.
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/locales"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// contextKey is the gin.Context key under which the negotiated
+// ut.Translator is stashed by Middleware.
+const contextKey = "i18n.translator"
+
+// RegisterFunc wires a locale's translations into v using trans, mirroring
+// the signature of the generated translations packages
+// (e.g. translations/en.RegisterDefaultTranslations).
+type RegisterFunc func(v *validator.Validate, trans ut.Translator) error
+
+// Translators negotiates a request locale from Accept-Language and
+// translates validator.ValidationErrors into that locale. The zero value
+// is not usable; construct one with New.
+type Translators struct {
+	validate *validator.Validate
+	uni      *ut.UniversalTranslator
+	fallback string
+}
+
+// New builds a Translators registry backed by validate, using fallback as
+// both the ut.UniversalTranslator's fallback locale and the locale served
+// when negotiation fails. Call RegisterLocale (including for fallbackTag
+// itself) to wire up translations before serving requests.
+//
+// New registers a tag-name function on validate so that field errors
+// report the request's wire field name (its "json" struct tag) rather
+// than the Go identifier, since the translated FieldError.Field is meant
+// for an API client to correlate back to the field it posted.
+func New(validate *validator.Validate, fallbackTag string, fallback locales.Translator) *Translators {
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		jsonTag := field.Tag.Get("json")
+		if jsonTag == "-" {
+			// No comma: the field is omitted from JSON entirely, not
+			// named "-" (that needs the `json:"-,"` escape instead).
+			return ""
+		}
+		return strings.SplitN(jsonTag, ",", 2)[0]
+	})
+	return &Translators{
+		validate: validate,
+		uni:      ut.New(fallback, fallback),
+		fallback: fallbackTag,
+	}
+}
+
+// RegisterLocale adds tag as a supported locale backed by locale, then
+// runs register against the validator's translator for tag. Callers
+// typically pass one of the
+// github.com/go-playground/validator/v10/translations/* packages'
+// RegisterDefaultTranslations function.
+func (t *Translators) RegisterLocale(tag string, locale locales.Translator, register RegisterFunc) error {
+	if err := t.uni.AddTranslator(locale, true); err != nil {
+		return fmt.Errorf("i18n: adding locale %q: %w", tag, err)
+	}
+	trans, found := t.uni.GetTranslator(tag)
+	if !found {
+		return fmt.Errorf("i18n: locale %q has no matching translator after AddTranslator", tag)
+	}
+	if err := register(t.validate, trans); err != nil {
+		return fmt.Errorf("i18n: registering translations for locale %q: %w", tag, err)
+	}
+	return nil
+}
+
+// Middleware negotiates a locale from the Accept-Language header and
+// stashes the resulting ut.Translator in the gin.Context for downstream
+// handlers (see Translator and AbortWithError).
+func (t *Translators) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		trans, found := t.uni.FindTranslator(acceptedLocales(c.GetHeader("Accept-Language"))...)
+		if !found {
+			trans, _ = t.uni.GetTranslator(t.fallback)
+		}
+		c.Set(contextKey, trans)
+		c.Next()
+	}
+}
+
+// acceptedLocales parses an Accept-Language header into locale tags
+// ordered by descending quality (RFC 7231 §5.3.5), dropping the optional
+// "q=" weights.
+func acceptedLocales(header string) []string {
+	if header == "" {
+		return nil
+	}
+
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var parsed []weighted
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		tag, q := part, 1.0
+		if i := strings.IndexByte(part, ';'); i >= 0 {
+			tag = strings.TrimSpace(part[:i])
+			fmt.Sscanf(strings.TrimSpace(part[i+1:]), "q=%f", &q)
+		}
+		if tag == "" {
+			continue
+		}
+		parsed = append(parsed, weighted{tag: tag, q: q})
+	}
+
+	for i := 1; i < len(parsed); i++ {
+		for j := i; j > 0 && parsed[j].q > parsed[j-1].q; j-- {
+			parsed[j], parsed[j-1] = parsed[j-1], parsed[j]
+		}
+	}
+
+	out := make([]string, len(parsed))
+	for i, w := range parsed {
+		out[i] = w.tag
+	}
+	return out
+}
+
+// Translator returns the ut.Translator negotiated by Middleware for the
+// current request, falling back to the registry's default locale if
+// Middleware wasn't installed.
+func (t *Translators) Translator(c *gin.Context) ut.Translator {
+	if v, ok := c.Get(contextKey); ok {
+		if trans, ok := v.(ut.Translator); ok {
+			return trans
+		}
+	}
+	trans, _ := t.uni.GetTranslator(t.fallback)
+	return trans
+}
+
+// FieldError is a single translated validation failure.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// TranslateErrors renders verrs into FieldErrors using trans.
+func TranslateErrors(verrs validator.ValidationErrors, trans ut.Translator) []FieldError {
+	out := make([]FieldError, 0, len(verrs))
+	for _, fe := range verrs {
+		out = append(out, FieldError{
+			Field:   fe.Field(),
+			Message: fe.Translate(trans),
+		})
+	}
+	return out
+}
+
+// AbortWithError writes a 400 JSON error body for err. If err is (or
+// wraps) validator.ValidationErrors it is translated via t and returned
+// as per-field messages; any other error is reported as a single
+// top-level message.
+func (t *Translators) AbortWithError(c *gin.Context, err error) {
+	var verrs validator.ValidationErrors
+	if errors.As(err, &verrs) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"errors": TranslateErrors(verrs, t.Translator(c)),
+		})
+		return
+	}
+	c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
@@ -0,0 +1,147 @@
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/es"
+	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	es_translations "github.com/go-playground/validator/v10/translations/es"
+)
+
+// EmailAddress intentionally differs from its "email" JSON tag so tests
+// can tell whether a reported field name came from the wire format or
+// the Go identifier.
+type signupRequest struct {
+	EmailAddress string `json:"email" binding:"required,email"`
+}
+
+func newTestTranslators(t *testing.T) *Translators {
+	t.Helper()
+	validate := binding.Validator.Engine().(*validator.Validate)
+	trans := New(validate, "en", en.New())
+	if err := trans.RegisterLocale("en", en.New(), en_translations.RegisterDefaultTranslations); err != nil {
+		t.Fatalf("RegisterLocale(en): %v", err)
+	}
+	if err := trans.RegisterLocale("es", es.New(), es_translations.RegisterDefaultTranslations); err != nil {
+		t.Fatalf("RegisterLocale(es): %v", err)
+	}
+	return trans
+}
+
+func TestAbortWithErrorTranslatesPerLocale(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	trans := newTestTranslators(t)
+
+	router := gin.New()
+	router.Use(trans.Middleware())
+	router.POST("/signup", func(c *gin.Context) {
+		var req signupRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			trans.AbortWithError(c, err)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	for _, tc := range []struct {
+		name           string
+		acceptLanguage string
+		wantContains   string
+	}{
+		{"default", "", "required"},
+		{"spanish", "es", "requerido"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(`{}`))
+			req.Header.Set("Content-Type", "application/json")
+			if tc.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tc.acceptLanguage)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+			if body := strings.ToLower(rec.Body.String()); !strings.Contains(body, tc.wantContains) {
+				t.Errorf("body = %q, want substring %q", rec.Body.String(), tc.wantContains)
+			}
+		})
+	}
+}
+
+func TestTranslateErrorsUsesJSONFieldName(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	trans := newTestTranslators(t)
+
+	router := gin.New()
+	router.Use(trans.Middleware())
+	router.POST("/signup", func(c *gin.Context) {
+		var req signupRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			trans.AbortWithError(c, err)
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+
+	var body struct {
+		Errors []FieldError `json:"errors"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("unmarshal response: %v, body = %s", err, rec.Body)
+	}
+	if len(body.Errors) != 1 {
+		t.Fatalf("errors = %+v, want exactly one", body.Errors)
+	}
+	if body.Errors[0].Field != "email" {
+		t.Errorf("Field = %q, want the JSON tag %q, not the Go identifier %q", body.Errors[0].Field, "email", "EmailAddress")
+	}
+}
+
+func TestAbortWithErrorTranslatesWrappedValidationErrors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	trans := newTestTranslators(t)
+	validate := binding.Validator.Engine().(*validator.Validate)
+
+	err := validate.Struct(signupRequest{})
+	if err == nil {
+		t.Fatal("expected a validation error for an empty signupRequest")
+	}
+	wrapped := fmt.Errorf("binding request: %w", err)
+
+	router := gin.New()
+	router.Use(trans.Middleware())
+	router.GET("/wrapped", func(c *gin.Context) {
+		trans.AbortWithError(c, wrapped)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/wrapped", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if body := strings.ToLower(rec.Body.String()); !strings.Contains(body, "required") {
+		t.Errorf("body = %q, want per-field translated message for wrapped validator.ValidationErrors", rec.Body.String())
+	}
+}
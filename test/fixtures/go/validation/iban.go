@@ -0,0 +1,46 @@
+package validation
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var ibanFormat = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
+
+// IBAN implements the `iban` tag: the field must look like an IBAN
+// (country code, check digits, BBAN) and pass the ISO 7064 mod-97
+// checksum used to catch typos.
+func IBAN(fl validator.FieldLevel) bool {
+	iban := strings.ToUpper(strings.ReplaceAll(fl.Field().String(), " ", ""))
+	if !ibanFormat.MatchString(iban) {
+		return false
+	}
+	return ibanChecksumValid(iban)
+}
+
+// ibanChecksumValid implements the ISO 7064 mod-97-10 check: move the
+// first four characters to the end, convert letters to numbers (A=10 ..
+// Z=35), then verify the resulting decimal number mod 97 equals 1.
+func ibanChecksumValid(iban string) bool {
+	rearranged := iban[4:] + iban[:4]
+
+	remainder := 0
+	for _, r := range rearranged {
+		var digits string
+		switch {
+		case r >= '0' && r <= '9':
+			digits = string(r)
+		case r >= 'A' && r <= 'Z':
+			digits = strconv.Itoa(int(r-'A') + 10)
+		default:
+			return false
+		}
+		for _, d := range digits {
+			remainder = (remainder*10 + int(d-'0')) % 97
+		}
+	}
+	return remainder == 1
+}
@@ -0,0 +1,16 @@
+package validation
+
+import (
+	"regexp"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var e164Pattern = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+
+// PhoneE164 implements the `phone_e164` tag, requiring the field to match
+// the ITU-T E.164 format (a leading '+', no leading zero, 2-15 digits
+// total).
+func PhoneE164(fl validator.FieldLevel) bool {
+	return e164Pattern.MatchString(fl.Field().String())
+}
@@ -0,0 +1,52 @@
+package validation
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// PwnedPrefixLen is the number of SHA-1 hex characters sent as the
+// k-anonymity prefix, matching the HaveIBeenPwned range API convention:
+// only the prefix is looked up, never the full hash of the candidate
+// password.
+const PwnedPrefixLen = 5
+
+// pwnedList holds known-compromised password hashes bucketed by their
+// k-anonymity prefix, mirroring the shape returned by a
+// range/{prefix}-style API: prefix -> set of remaining hash suffixes.
+var pwnedList = struct {
+	mu   sync.RWMutex
+	data map[string]map[string]bool
+}{data: map[string]map[string]bool{}}
+
+// SetPwnedList replaces the in-process k-anonymity prefix list consulted
+// by the `not_pwned_prefix` tag. list maps a PwnedPrefixLen-character
+// uppercase hex prefix to the set of uppercase hex suffixes known to be
+// compromised. Passing nil clears it, making not_pwned_prefix permissive
+// (useful in tests).
+func SetPwnedList(list map[string]map[string]bool) {
+	pwnedList.mu.Lock()
+	defer pwnedList.mu.Unlock()
+	pwnedList.data = list
+	if pwnedList.data == nil {
+		pwnedList.data = map[string]map[string]bool{}
+	}
+}
+
+// NotPwnedPrefix implements the `not_pwned_prefix` tag: it SHA-1 hashes
+// the field, looks its k-anonymity prefix up in the list configured via
+// SetPwnedList, and rejects the value only if the remaining suffix is
+// present in that bucket.
+func NotPwnedPrefix(fl validator.FieldLevel) bool {
+	sum := sha1.Sum([]byte(fl.Field().String()))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:PwnedPrefixLen], hash[PwnedPrefixLen:]
+
+	pwnedList.mu.RLock()
+	defer pwnedList.mu.RUnlock()
+	return !pwnedList.data[prefix][suffix]
+}
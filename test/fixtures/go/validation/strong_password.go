@@ -0,0 +1,44 @@
+package validation
+
+import (
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const strongPasswordMinLength = 12
+
+// strongPasswordMaxLength mirrors golang.org/x/crypto/bcrypt's hard
+// 72-byte input limit: bcrypt.GenerateFromPassword rejects a longer
+// password with bcrypt.ErrPasswordTooLong. Pinned as a plain constant rather than probed via
+// bcrypt.GenerateFromPassword on every call, since that would hash the
+// candidate just to re-derive a length check; TestStrongPasswordMaxLengthMatchesBcrypt
+// keeps this constant honest against the dependency.
+const strongPasswordMaxLength = 72
+
+// StrongPassword implements the `strong_password` tag: it requires a
+// length bcrypt can actually spend its cost factor on (between 12 and 72
+// bytes) plus a mix of character classes, so callers don't end up
+// hashing low-entropy input, or input bcrypt refuses as too long, with
+// golang.org/x/crypto/bcrypt.
+func StrongPassword(fl validator.FieldLevel) bool {
+	pw := fl.Field().String()
+	if len(pw) < strongPasswordMinLength || len(pw) > strongPasswordMaxLength {
+		return false
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range pw {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r), unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+	return hasUpper && hasLower && hasDigit && hasSymbol
+}
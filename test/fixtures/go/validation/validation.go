@@ -0,0 +1,60 @@
+// Package validation registers this project's custom tags and
+// cross-field rules against the shared *validator.Validate instance that
+// Gin uses for request binding (binding.Validator.Engine()).
+package validation
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// RegisterAll wires every custom tag in this package into v. Call it once
+// from server init, e.g.:
+//
+//	validation.RegisterAll(binding.Validator.Engine().(*validator.Validate))
+func RegisterAll(v *validator.Validate) error {
+	tags := map[string]validator.Func{
+		"strong_password":  StrongPassword,
+		"phone_e164":       PhoneE164,
+		"iban":             IBAN,
+		"not_pwned_prefix": NotPwnedPrefix,
+		"field_depends_on": FieldDependsOn,
+	}
+	for tag, fn := range tags {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			return fmt.Errorf("validation: registering tag %q: %w", tag, err)
+		}
+	}
+	return nil
+}
+
+// FieldDependsOn implements the `field_depends_on=OtherField` cross-field
+// rule: the tagged field is only required to be non-zero when its sibling
+// named by the tag parameter is itself non-zero. It is a no-op (always
+// valid) when the parameter names a field that doesn't exist.
+func FieldDependsOn(fl validator.FieldLevel) bool {
+	param := fl.Param()
+	if param == "" {
+		return true
+	}
+
+	parent := fl.Parent()
+	for parent.Kind() == reflect.Ptr {
+		parent = parent.Elem()
+	}
+	if parent.Kind() != reflect.Struct {
+		return true
+	}
+
+	dependsOn := parent.FieldByName(param)
+	if !dependsOn.IsValid() {
+		return true
+	}
+	if dependsOn.IsZero() {
+		// Dependency absent: nothing to enforce.
+		return true
+	}
+	return !fl.Field().IsZero()
+}
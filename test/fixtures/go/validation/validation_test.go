@@ -0,0 +1,151 @@
+package validation
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newValidate(t *testing.T) *validator.Validate {
+	t.Helper()
+	v := validator.New()
+	if err := RegisterAll(v); err != nil {
+		t.Fatalf("RegisterAll: %v", err)
+	}
+	return v
+}
+
+func TestStrongPassword(t *testing.T) {
+	v := newValidate(t)
+
+	type form struct {
+		Password string `validate:"strong_password"`
+	}
+
+	for _, tc := range []struct {
+		name    string
+		pw      string
+		wantErr bool
+	}{
+		{"too short", "Ab1!", true},
+		{"missing symbol", "Abcdefgh1234", true},
+		{"strong", "Abcdefg1234!", false},
+		{"too long for bcrypt", strings.Repeat("Aa1!", 20), true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := v.Struct(form{Password: tc.pw})
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Struct(%q) error = %v, wantErr %v", tc.pw, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// TestStrongPasswordMaxLengthMatchesBcrypt pins strongPasswordMaxLength
+// to bcrypt's actual input length limit, so a dependency upgrade that
+// changed it would fail this test instead of silently drifting.
+func TestStrongPasswordMaxLengthMatchesBcrypt(t *testing.T) {
+	atLimit := bytes.Repeat([]byte("a"), strongPasswordMaxLength)
+	if _, err := bcrypt.GenerateFromPassword(atLimit, bcrypt.MinCost); err != nil {
+		t.Errorf("bcrypt rejected a %d-byte password: %v", strongPasswordMaxLength, err)
+	}
+
+	overLimit := bytes.Repeat([]byte("a"), strongPasswordMaxLength+1)
+	if _, err := bcrypt.GenerateFromPassword(overLimit, bcrypt.MinCost); !errors.Is(err, bcrypt.ErrPasswordTooLong) {
+		t.Errorf("bcrypt.GenerateFromPassword(%d bytes) error = %v, want %v", strongPasswordMaxLength+1, err, bcrypt.ErrPasswordTooLong)
+	}
+}
+
+func TestPhoneE164(t *testing.T) {
+	v := newValidate(t)
+
+	type form struct {
+		Phone string `validate:"phone_e164"`
+	}
+
+	for _, tc := range []struct {
+		phone   string
+		wantErr bool
+	}{
+		{"+14155552671", false},
+		{"+442071838750", false},
+		{"04155552671", true},
+		{"not-a-phone", true},
+	} {
+		err := v.Struct(form{Phone: tc.phone})
+		if (err != nil) != tc.wantErr {
+			t.Errorf("Struct(%q) error = %v, wantErr %v", tc.phone, err, tc.wantErr)
+		}
+	}
+}
+
+func TestIBAN(t *testing.T) {
+	v := newValidate(t)
+
+	type form struct {
+		IBAN string `validate:"iban"`
+	}
+
+	for _, tc := range []struct {
+		iban    string
+		wantErr bool
+	}{
+		{"GB29NWBK60161331926819", false},
+		{"DE89370400440532013000", false},
+		{"GB29NWBK60161331926818", true}, // bad checksum
+		{"NOTANIBAN", true},
+	} {
+		err := v.Struct(form{IBAN: tc.iban})
+		if (err != nil) != tc.wantErr {
+			t.Errorf("Struct(%q) error = %v, wantErr %v", tc.iban, err, tc.wantErr)
+		}
+	}
+}
+
+func TestNotPwnedPrefix(t *testing.T) {
+	v := newValidate(t)
+	t.Cleanup(func() { SetPwnedList(nil) })
+
+	const pwned = "password123"
+	sum := sha1.Sum([]byte(pwned))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	SetPwnedList(map[string]map[string]bool{
+		hash[:PwnedPrefixLen]: {hash[PwnedPrefixLen:]: true},
+	})
+
+	type form struct {
+		Password string `validate:"not_pwned_prefix"`
+	}
+
+	if err := v.Struct(form{Password: pwned}); err == nil {
+		t.Error("expected error for known-pwned password, got nil")
+	}
+	if err := v.Struct(form{Password: "a-unique-passphrase"}); err != nil {
+		t.Errorf("unexpected error for non-pwned password: %v", err)
+	}
+}
+
+func TestFieldDependsOn(t *testing.T) {
+	v := newValidate(t)
+
+	type form struct {
+		ShipToCountry string `validate:"-"`
+		ShipToZip     string `validate:"field_depends_on=ShipToCountry"`
+	}
+
+	if err := v.Struct(form{}); err != nil {
+		t.Errorf("neither field set: unexpected error: %v", err)
+	}
+	if err := v.Struct(form{ShipToCountry: "US"}); err == nil {
+		t.Error("country set without zip: expected error, got nil")
+	}
+	if err := v.Struct(form{ShipToCountry: "US", ShipToZip: "94107"}); err != nil {
+		t.Errorf("both set: unexpected error: %v", err)
+	}
+}